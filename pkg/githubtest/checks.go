@@ -0,0 +1,114 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/github"
+)
+
+func atoi64Or400(w http.ResponseWriter, s string) (int64, bool) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return 0, false
+	}
+	return n, true
+}
+
+func (gh *FakeGitHub) createCheckRun(w http.ResponseWriter, r *http.Request, m []string) {
+	var opt github.CreateCheckRunOptions
+	if err := json.NewDecoder(r.Body).Decode(&opt); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	status := opt.Status
+	if status == nil {
+		status = github.String("queued")
+	}
+	cr := &github.CheckRun{
+		Name:       &opt.Name,
+		HeadSHA:    &opt.HeadSHA,
+		Status:     status,
+		Conclusion: opt.Conclusion,
+		ExternalID: opt.ExternalID,
+		Output:     opt.Output,
+	}
+	gh.AddCheckRun(opt.HeadSHA, cr)
+	writeJSON(w, http.StatusCreated, cr)
+}
+
+func (gh *FakeGitHub) updateCheckRun(w http.ResponseWriter, r *http.Request, m []string) {
+	id, ok := atoi64Or400(w, m[0])
+	if !ok {
+		return
+	}
+	cr := gh.findCheckRun(id)
+	if cr == nil {
+		http.Error(w, "check run not found", http.StatusNotFound)
+		return
+	}
+	var opt github.UpdateCheckRunOptions
+	if err := json.NewDecoder(r.Body).Decode(&opt); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if opt.Name != "" {
+		cr.Name = &opt.Name
+	}
+	if opt.Status != nil {
+		cr.Status = opt.Status
+	}
+	if opt.Conclusion != nil {
+		cr.Conclusion = opt.Conclusion
+	}
+	if opt.Output != nil {
+		cr.Output = opt.Output
+	}
+	writeJSON(w, http.StatusOK, cr)
+}
+
+func (gh *FakeGitHub) listCheckRunsForRef(w http.ResponseWriter, r *http.Request, m []string) {
+	sha := m[0]
+	runs := gh.CheckRuns[sha]
+	start, end := paginate(w, r, len(runs))
+	writeJSON(w, http.StatusOK, &github.ListCheckRunsResults{
+		Total:     github.Int(len(runs)),
+		CheckRuns: runs[start:end],
+	})
+}
+
+func (gh *FakeGitHub) listCheckRunAnnotations(w http.ResponseWriter, r *http.Request, m []string) {
+	id, ok := atoi64Or400(w, m[0])
+	if !ok {
+		return
+	}
+	cr := gh.findCheckRun(id)
+	if cr == nil {
+		http.Error(w, "check run not found", http.StatusNotFound)
+		return
+	}
+	var annotations []*github.CheckRunAnnotation
+	if cr.Output != nil {
+		annotations = cr.Output.Annotations
+	}
+	start, end := paginate(w, r, len(annotations))
+	writeJSON(w, http.StatusOK, annotations[start:end])
+}
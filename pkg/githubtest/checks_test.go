@@ -0,0 +1,82 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubtest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+func TestFakeGitHubCheckRuns(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+
+	sha := "deadbeef"
+
+	created, resp, err := client.Checks.CreateCheckRun(ctx, owner, repo, github.CreateCheckRunOptions{
+		Name:    "unit-tests",
+		HeadSHA: sha,
+		Status:  github.String("in_progress"),
+	})
+	if err != nil || resp.StatusCode != http.StatusCreated {
+		t.Fatalf("CreateCheckRun: wanted Created, got %+v, %v", resp, err)
+	}
+	if created.GetStatus() != "in_progress" {
+		t.Errorf("CreateCheckRun: wanted status in_progress, got %q", created.GetStatus())
+	}
+
+	updated, _, err := client.Checks.UpdateCheckRun(ctx, owner, repo, created.GetID(), github.UpdateCheckRunOptions{
+		Status:     github.String("completed"),
+		Conclusion: github.String("success"),
+		Output: &github.CheckRunOutput{
+			Title:   github.String("All green"),
+			Summary: github.String("12 passed"),
+			Annotations: []*github.CheckRunAnnotation{
+				{FileName: github.String("main.go"), Message: github.String("nit")},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateCheckRun: %v", err)
+	}
+	if updated.GetConclusion() != "success" {
+		t.Errorf("UpdateCheckRun: wanted conclusion success, got %q", updated.GetConclusion())
+	}
+
+	list, _, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, nil)
+	if err != nil {
+		t.Fatalf("ListCheckRunsForRef: %v", err)
+	}
+	if list.GetTotal() != 1 || len(list.CheckRuns) != 1 {
+		t.Fatalf("ListCheckRunsForRef: wanted 1 check run, got %+v", list)
+	}
+	if list.CheckRuns[0].GetConclusion() != "success" {
+		t.Errorf("ListCheckRunsForRef: wanted conclusion success, got %q", list.CheckRuns[0].GetConclusion())
+	}
+
+	annotations, _, err := client.Checks.ListCheckRunAnnotations(ctx, owner, repo, created.GetID(), nil)
+	if err != nil {
+		t.Fatalf("ListCheckRunAnnotations: %v", err)
+	}
+	if len(annotations) != 1 || annotations[0].GetFileName() != "main.go" {
+		t.Errorf("ListCheckRunAnnotations: wanted [main.go], got %+v", annotations)
+	}
+}
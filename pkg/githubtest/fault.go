@@ -0,0 +1,114 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// defaultRateLimit is the X-RateLimit-Limit FakeGitHub reports until
+// SetRateLimit is called.
+const defaultRateLimit = 5000
+
+// injectedError is a fault registered via InjectError.
+type injectedError struct {
+	method  string
+	path    *regexp.Regexp
+	status  int
+	body    string
+	headers http.Header
+}
+
+// SetRateLimit configures the X-RateLimit-* headers FakeGitHub attaches to
+// every response. Once remaining reaches zero, subsequent requests fail
+// with a 403 rate-limit response, matching go-github's RateLimitError
+// detection (403 plus X-RateLimit-Remaining: 0).
+func (gh *FakeGitHub) SetRateLimit(remaining int, resetAt time.Time) {
+	gh.rateLimitSet = true
+	gh.rateLimitRemaining = remaining
+	gh.rateLimitReset = resetAt
+}
+
+// InjectError causes every request matching method and pathPattern (a
+// regexp matched against the request path) to fail with status and body
+// instead of being served normally, until ClearErrors is called. headers
+// is applied to the response before status and body are written, so
+// callers can exercise responses that depend on headers GitHub itself
+// sets, such as Retry-After on abuse-detection 403s; it may be nil.
+func (gh *FakeGitHub) InjectError(method, pathPattern string, status int, body string, headers http.Header) {
+	gh.injectedErrors = append(gh.injectedErrors, injectedError{
+		method:  method,
+		path:    regexp.MustCompile(pathPattern),
+		status:  status,
+		body:    body,
+		headers: headers,
+	})
+}
+
+// ClearErrors removes all errors registered with InjectError.
+func (gh *FakeGitHub) ClearErrors() {
+	gh.injectedErrors = nil
+}
+
+// SetLatency adds a fixed delay before FakeGitHub responds to any request,
+// to exercise client-side timeouts and retry/back-off logic.
+func (gh *FakeGitHub) SetLatency(d time.Duration) {
+	gh.latency = d
+}
+
+func (gh *FakeGitHub) writeRateLimitHeaders(w http.ResponseWriter) {
+	remaining := defaultRateLimit
+	reset := time.Now().Add(time.Hour)
+	if gh.rateLimitSet {
+		remaining = gh.rateLimitRemaining
+		reset = gh.rateLimitReset
+	}
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(defaultRateLimit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+}
+
+func (gh *FakeGitHub) serveInjectedError(w http.ResponseWriter, r *http.Request) bool {
+	for _, e := range gh.injectedErrors {
+		if e.method == r.Method && e.path.MatchString(r.URL.Path) {
+			for k, vs := range e.headers {
+				for _, v := range vs {
+					w.Header().Add(k, v)
+				}
+			}
+			http.Error(w, e.body, e.status)
+			return true
+		}
+	}
+	return false
+}
+
+func (gh *FakeGitHub) serveRateLimitExceeded(w http.ResponseWriter, r *http.Request) bool {
+	if !gh.rateLimitSet || gh.rateLimitRemaining > 0 {
+		return false
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "API rate limit exceeded for 127.0.0.1.",
+	})
+	return true
+}
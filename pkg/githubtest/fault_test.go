@@ -0,0 +1,109 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubtest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+func TestFakeGitHubRateLimit(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+	gh.AddPullRequest(pr)
+
+	resetAt := time.Now().Add(time.Minute)
+	gh.SetRateLimit(0, resetAt)
+
+	_, resp, err := client.PullRequests.Get(ctx, owner, repo, prNum)
+	if err == nil {
+		t.Fatal("Get PullRequest: wanted rate limit error, got nil")
+	}
+	rlErr, ok := err.(*github.RateLimitError)
+	if !ok {
+		t.Fatalf("Get PullRequest: wanted *github.RateLimitError, got %T: %v", err, err)
+	}
+	if rlErr.Rate.Remaining != 0 {
+		t.Errorf("RateLimitError.Rate.Remaining: wanted 0, got %d", rlErr.Rate.Remaining)
+	}
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("StatusCode: wanted 403, got %d", resp.StatusCode)
+	}
+}
+
+func TestFakeGitHubInjectError(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+	gh.AddPullRequest(pr)
+
+	gh.InjectError(http.MethodGet, `/pulls/\d+$`, http.StatusInternalServerError, "server exploded", nil)
+
+	if _, resp, err := client.PullRequests.Get(ctx, owner, repo, prNum); err == nil || resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("Get PullRequest: wanted 500, got %+v, %v", resp, err)
+	}
+
+	gh.ClearErrors()
+
+	if _, resp, err := client.PullRequests.Get(ctx, owner, repo, prNum); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("Get PullRequest after ClearErrors: wanted OK, got %+v, %v", resp, err)
+	}
+}
+
+func TestFakeGitHubInjectErrorAbuseRateLimit(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+	gh.AddPullRequest(pr)
+
+	gh.InjectError(http.MethodGet, `/pulls/\d+$`, http.StatusForbidden,
+		`{"message":"You have triggered an abuse detection mechanism. Please wait a few minutes before you try again."}`,
+		http.Header{"Retry-After": []string{"30"}})
+
+	_, _, err := client.PullRequests.Get(ctx, owner, repo, prNum)
+	if err == nil {
+		t.Fatal("Get PullRequest: wanted abuse rate limit error, got nil")
+	}
+	abuseErr, ok := err.(*github.AbuseRateLimitError)
+	if !ok {
+		t.Fatalf("Get PullRequest: wanted *github.AbuseRateLimitError, got %T: %v", err, err)
+	}
+	if abuseErr.RetryAfter == nil || *abuseErr.RetryAfter != 30*time.Second {
+		t.Errorf("AbuseRateLimitError.RetryAfter: wanted 30s, got %v", abuseErr.RetryAfter)
+	}
+}
+
+func TestFakeGitHubLatency(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+	gh.AddPullRequest(pr)
+	gh.SetLatency(20 * time.Millisecond)
+
+	start := time.Now()
+	if _, _, err := client.PullRequests.Get(ctx, owner, repo, prNum); err != nil {
+		t.Fatalf("Get PullRequest: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Get PullRequest: wanted at least 20ms latency, took %s", elapsed)
+	}
+}
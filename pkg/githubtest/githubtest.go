@@ -0,0 +1,260 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package githubtest provides an in-memory fake of the GitHub v3 REST API
+// surface that this module's PR resources exercise. It is consumed by this
+// module's own tests, but is also exported so that downstream Tekton
+// components and third-party pipeline plugins can unit test code that talks
+// to GitHub without standing up a live server.
+package githubtest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+// PullRequests mirrors the subset of github.PullRequestsService that this
+// module depends on, so that callers can swap FakeGitHub and a real
+// github.Client behind the same interface.
+type PullRequests interface {
+	Get(ctx context.Context, owner, repo string, number int) (*github.PullRequest, *github.Response, error)
+	ListReviews(ctx context.Context, owner, repo string, number int, opt *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error)
+	CreateReview(ctx context.Context, owner, repo string, number int, review *github.PullRequestReviewRequest) (*github.PullRequestReview, *github.Response, error)
+	DismissReview(ctx context.Context, owner, repo string, number int, reviewID int64, review *github.PullRequestReviewDismissalRequest) (*github.PullRequestReview, *github.Response, error)
+	ListReviewers(ctx context.Context, owner, repo string, number int, opt *github.ListOptions) (*github.Reviewers, *github.Response, error)
+	RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers github.ReviewersRequest) (*github.PullRequest, *github.Response, error)
+	Merge(ctx context.Context, owner, repo string, number int, commitMessage string, options *github.PullRequestOptions) (*github.PullRequestMergeResult, *github.Response, error)
+	ListFiles(ctx context.Context, owner, repo string, number int, opt *github.ListOptions) ([]*github.CommitFile, *github.Response, error)
+}
+
+// Issues mirrors the subset of github.IssuesService that this module
+// depends on.
+type Issues interface {
+	Get(ctx context.Context, owner, repo string, number int) (*github.Issue, *github.Response, error)
+	Create(ctx context.Context, owner, repo string, issue *github.IssueRequest) (*github.Issue, *github.Response, error)
+	Edit(ctx context.Context, owner, repo string, number int, issue *github.IssueRequest) (*github.Issue, *github.Response, error)
+	ListByRepo(ctx context.Context, owner, repo string, opt *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error)
+	ListIssueTimeline(ctx context.Context, owner, repo string, number int, opt *github.ListOptions) ([]*github.Timeline, *github.Response, error)
+	ListComments(ctx context.Context, owner, repo string, number int, opt *github.IssueListCommentsOptions) ([]*github.IssueComment, *github.Response, error)
+	CreateComment(ctx context.Context, owner, repo string, number int, comment *github.IssueComment) (*github.IssueComment, *github.Response, error)
+	ListLabelsByIssue(ctx context.Context, owner, repo string, number int, opt *github.ListOptions) ([]*github.Label, *github.Response, error)
+	AddLabelsToIssue(ctx context.Context, owner, repo string, number int, labels []string) ([]*github.Label, *github.Response, error)
+	ReplaceLabelsForIssue(ctx context.Context, owner, repo string, number int, labels []string) ([]*github.Label, *github.Response, error)
+}
+
+// Repositories mirrors the subset of github.RepositoriesService that this
+// module depends on.
+type Repositories interface {
+	GetCombinedStatus(ctx context.Context, owner, repo, ref string, opt *github.ListOptions) (*github.CombinedStatus, *github.Response, error)
+	CreateStatus(ctx context.Context, owner, repo, ref string, status *github.RepoStatus) (*github.RepoStatus, *github.Response, error)
+}
+
+// Checks mirrors the subset of github.ChecksService that this module
+// depends on.
+type Checks interface {
+	CreateCheckRun(ctx context.Context, owner, repo string, opt github.CreateCheckRunOptions) (*github.CheckRun, *github.Response, error)
+	UpdateCheckRun(ctx context.Context, owner, repo string, checkRunID int64, opt github.UpdateCheckRunOptions) (*github.CheckRun, *github.Response, error)
+	ListCheckRunsForRef(ctx context.Context, owner, repo, ref string, opt *github.ListCheckRunsOptions) (*github.ListCheckRunsResults, *github.Response, error)
+	ListCheckRunAnnotations(ctx context.Context, owner, repo string, checkRunID int64, opt *github.ListOptions) ([]*github.CheckRunAnnotation, *github.Response, error)
+}
+
+// FakeGitHub is an in-memory implementation of the GitHub endpoints this
+// module's PR resources call. It implements http.Handler so that it can
+// back a real *github.Client through httptest.NewServer.
+type FakeGitHub struct {
+	PullRequests map[int]*github.PullRequest
+	Issues       map[int]*github.Issue
+	Timeline     map[int][]*github.Timeline
+	Comments     map[int][]*github.IssueComment
+	Statuses     map[string][]*github.RepoStatus
+	Labels       map[int][]*github.Label
+	CheckRuns    map[string][]*github.CheckRun
+
+	Reviews            map[int][]*github.PullRequestReview
+	RequestedReviewers map[int]*github.Reviewers
+	Files              map[int][]*github.CommitFile
+
+	// MergeMethods records the MergeMethod ("merge", "squash", or
+	// "rebase") passed to the most recent successful PullRequests.Merge
+	// call for each PR number, so tests can assert on it.
+	MergeMethods map[int]string
+
+	nextIssueNumber int
+	nextCheckRunID  int64
+	nextReviewID    int64
+
+	rateLimitSet       bool
+	rateLimitRemaining int
+	rateLimitReset     time.Time
+	latency            time.Duration
+	injectedErrors     []injectedError
+}
+
+// NewFakeGitHub returns an empty FakeGitHub ready to be seeded and served.
+func NewFakeGitHub() *FakeGitHub {
+	return &FakeGitHub{
+		PullRequests: map[int]*github.PullRequest{},
+		Issues:       map[int]*github.Issue{},
+		Timeline:     map[int][]*github.Timeline{},
+		Comments:     map[int][]*github.IssueComment{},
+		Statuses:     map[string][]*github.RepoStatus{},
+		Labels:       map[int][]*github.Label{},
+		CheckRuns:    map[string][]*github.CheckRun{},
+
+		Reviews:            map[int][]*github.PullRequestReview{},
+		RequestedReviewers: map[int]*github.Reviewers{},
+		Files:              map[int][]*github.CommitFile{},
+		MergeMethods:       map[int]string{},
+
+		nextIssueNumber: 1,
+		nextCheckRunID:  1,
+		nextReviewID:    1,
+	}
+}
+
+// AddPullRequest seeds gh with pr, keyed by its number.
+func (gh *FakeGitHub) AddPullRequest(pr *github.PullRequest) {
+	gh.PullRequests[pr.GetNumber()] = pr
+}
+
+// AddPullRequestFiles seeds gh with the files changed by the PR numbered
+// prNum, as returned by PullRequests.ListFiles.
+func (gh *FakeGitHub) AddPullRequestFiles(prNum int, files []*github.CommitFile) {
+	gh.Files[prNum] = files
+}
+
+// AddIssue seeds gh with issue. If issue has no number, one is assigned.
+func (gh *FakeGitHub) AddIssue(issue *github.Issue) {
+	if issue.GetNumber() == 0 {
+		issue.Number = github.Int(gh.nextIssueNumber)
+	}
+	if issue.Number != nil && *issue.Number >= gh.nextIssueNumber {
+		gh.nextIssueNumber = *issue.Number + 1
+	}
+	gh.Issues[issue.GetNumber()] = issue
+}
+
+// AddCheckRun seeds gh with a check run for sha. If cr has no ID, one is
+// assigned.
+func (gh *FakeGitHub) AddCheckRun(sha string, cr *github.CheckRun) {
+	if cr.GetID() == 0 {
+		cr.ID = github.Int64(gh.nextCheckRunID)
+	}
+	if cr.GetID() >= gh.nextCheckRunID {
+		gh.nextCheckRunID = cr.GetID() + 1
+	}
+	gh.CheckRuns[sha] = append(gh.CheckRuns[sha], cr)
+}
+
+func (gh *FakeGitHub) findCheckRun(id int64) *github.CheckRun {
+	for _, runs := range gh.CheckRuns {
+		for _, cr := range runs {
+			if cr.GetID() == id {
+				return cr
+			}
+		}
+	}
+	return nil
+}
+
+func (gh *FakeGitHub) addTimelineEvent(number int, event string) {
+	gh.Timeline[number] = append(gh.Timeline[number], &github.Timeline{
+		Event: github.String(event),
+	})
+}
+
+// ServeHTTP implements http.Handler by routing requests to the in-memory
+// fake. Paths that don't match a known GitHub API route, or that have a
+// malformed numeric path component, are rejected with 400 Bad Request.
+// Fault injection configured via SetLatency, InjectError and SetRateLimit
+// is applied before routing: see fault.go.
+func (gh *FakeGitHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if gh.latency > 0 {
+		time.Sleep(gh.latency)
+	}
+	gh.writeRateLimitHeaders(w)
+
+	if handled := gh.serveInjectedError(w, r); handled {
+		return
+	}
+	if handled := gh.serveRateLimitExceeded(w, r); handled {
+		return
+	}
+
+	for _, rt := range routes {
+		if r.Method != rt.method {
+			continue
+		}
+		if m := rt.path.FindStringSubmatch(r.URL.Path); m != nil {
+			rt.handler(gh, w, r, m[1:])
+			return
+		}
+	}
+	http.Error(w, "no route for "+r.Method+" "+r.URL.Path, http.StatusBadRequest)
+}
+
+// Config controls how Setup wires a FakeGitHub into a *github.Client.
+type Config struct {
+	// GitHub is the fake to serve. If nil, Setup creates an empty one.
+	GitHub *FakeGitHub
+
+	// Mux, if set, is used instead of a freshly created *http.ServeMux.
+	// Callers can pre-populate it with handlers for endpoints the fake
+	// does not (yet) cover before passing it in.
+	Mux *http.ServeMux
+}
+
+// Setup starts an httptest.Server backing gh (or a new FakeGitHub, if
+// c.GitHub is nil) and returns a *github.Client pointed at it, along with
+// the *http.ServeMux the server is built on. Callers may register
+// additional routes on the returned mux, via mux.HandleFunc, for endpoints
+// FakeGitHub does not yet implement. The server is closed automatically
+// when the test completes.
+func Setup(ctx context.Context, t *testing.T, c *Config) (*github.Client, *http.ServeMux) {
+	t.Helper()
+
+	if c == nil {
+		c = &Config{}
+	}
+	gh := c.GitHub
+	if gh == nil {
+		gh = NewFakeGitHub()
+	}
+	mux := c.Mux
+	if mux == nil {
+		mux = http.NewServeMux()
+	}
+	mux.Handle("/", gh)
+
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	u, err := url.Parse(s.URL + "/")
+	if err != nil {
+		t.Fatalf("parsing fake server URL: %v", err)
+	}
+
+	client := github.NewClient(nil)
+	client.BaseURL = u
+	client.UploadURL = u
+	return client, mux
+}
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package main
+package githubtest
 
 import (
 	"context"
@@ -27,11 +27,26 @@ import (
 	"github.com/google/go-github/github"
 )
 
+const (
+	owner = "wizzbang"
+	repo  = "foo"
+	prNum = 1
+)
+
+var (
+	pr = &github.PullRequest{
+		Number: github.Int(prNum),
+		Title:  github.String("Some PR"),
+	}
+	comment = &github.IssueComment{
+		Body: github.String("Some comment"),
+	}
+)
+
 func TestFakeGitHubPullRequest(t *testing.T) {
 	ctx := context.Background()
 	gh := NewFakeGitHub()
-	client, close := githubClient(t, gh)
-	defer close()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
 
 	if _, resp, err := client.PullRequests.Get(ctx, owner, repo, prNum); err == nil || resp.StatusCode != http.StatusNotFound {
 		t.Fatalf("Get PullRequest: wanted not found, got %+v, %v", resp, err)
@@ -50,8 +65,7 @@ func TestFakeGitHubPullRequest(t *testing.T) {
 func TestFakeGitHubComments(t *testing.T) {
 	ctx := context.Background()
 	gh := NewFakeGitHub()
-	client, close := githubClient(t, gh)
-	defer close()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
 
 	if got, resp, err := client.Issues.ListComments(ctx, owner, repo, prNum, nil); err != nil || resp.StatusCode != http.StatusOK || len(got) != 0 {
 		t.Fatalf("List Issues: wanted [], got %+v, %+v, %v", got, resp, err)
@@ -84,8 +98,7 @@ func TestFakeGitHubBadKey(t *testing.T) {
 func TestFakeGitHubStatus(t *testing.T) {
 	ctx := context.Background()
 	gh := NewFakeGitHub()
-	client, close := githubClient(t, gh)
-	defer close()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
 
 	sha := "tacocat"
 
@@ -119,8 +132,7 @@ func TestFakeGitHubStatus(t *testing.T) {
 func TestFakeGitHubLabels(t *testing.T) {
 	ctx := context.Background()
 	gh := NewFakeGitHub()
-	client, close := githubClient(t, gh)
-	defer close()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
 	gh.AddPullRequest(pr)
 
 	if got, resp, err := client.Issues.ListLabelsByIssue(ctx, owner, repo, prNum, nil); err != nil || len(got) != 0 {
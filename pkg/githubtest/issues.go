@@ -0,0 +1,208 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+func (gh *FakeGitHub) getIssue(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	issue, ok := gh.Issues[num]
+	if !ok {
+		http.Error(w, "issue not found", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, issue)
+}
+
+func (gh *FakeGitHub) createIssue(w http.ResponseWriter, r *http.Request, m []string) {
+	var req github.IssueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	now := time.Now()
+	issue := &github.Issue{
+		Number:    github.Int(gh.nextIssueNumber),
+		Title:     req.Title,
+		Body:      req.Body,
+		State:     github.String("open"),
+		Labels:    labelsFromNames(stringSlice(req.Labels)),
+		Assignees: usersFromLogins(stringSlice(req.Assignees)),
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+	gh.nextIssueNumber++
+	gh.Issues[issue.GetNumber()] = issue
+	writeJSON(w, http.StatusCreated, issue)
+}
+
+func (gh *FakeGitHub) editIssue(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	issue, ok := gh.Issues[num]
+	if !ok {
+		http.Error(w, "issue not found", http.StatusNotFound)
+		return
+	}
+	var req github.IssueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.Title != nil {
+		issue.Title = req.Title
+	}
+	if req.Body != nil {
+		issue.Body = req.Body
+	}
+	if req.Assignees != nil {
+		issue.Assignees = usersFromLogins(stringSlice(req.Assignees))
+	}
+	if req.State != nil && req.GetState() != issue.GetState() {
+		issue.State = req.State
+		if req.GetState() == "closed" {
+			gh.addTimelineEvent(num, "closed")
+		} else {
+			gh.addTimelineEvent(num, "reopened")
+		}
+	}
+	now := time.Now()
+	issue.UpdatedAt = &now
+	writeJSON(w, http.StatusOK, issue)
+}
+
+func (gh *FakeGitHub) listIssuesByRepo(w http.ResponseWriter, r *http.Request, m []string) {
+	q := r.URL.Query()
+	wantState := q.Get("state")
+	if wantState == "" {
+		wantState = "open"
+	}
+	wantAssignee := q.Get("assignee")
+	var wantLabels []string
+	if l := q.Get("labels"); l != "" {
+		wantLabels = splitCSV(l)
+	}
+	var since time.Time
+	if s := q.Get("since"); s != "" {
+		parsed, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		since = parsed
+	}
+
+	var matched []*github.Issue
+	for _, issue := range gh.Issues {
+		if wantState != "" && wantState != "all" && issue.GetState() != wantState {
+			continue
+		}
+		if wantAssignee != "" && !hasAssignee(issue, wantAssignee) {
+			continue
+		}
+		if len(wantLabels) > 0 && !hasAllLabels(issue, wantLabels) {
+			continue
+		}
+		if !since.IsZero() && issue.GetUpdatedAt().Before(since) {
+			continue
+		}
+		matched = append(matched, issue)
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].GetNumber() < matched[j].GetNumber() })
+	start, end := paginate(w, r, len(matched))
+	writeJSON(w, http.StatusOK, matched[start:end])
+}
+
+func (gh *FakeGitHub) listIssueTimeline(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, gh.Timeline[num])
+}
+
+func labelsFromNames(names []string) []github.Label {
+	labels := make([]github.Label, 0, len(names))
+	for _, n := range names {
+		labels = append(labels, github.Label{Name: github.String(n)})
+	}
+	return labels
+}
+
+func usersFromLogins(logins []string) []*github.User {
+	users := make([]*github.User, 0, len(logins))
+	for _, l := range logins {
+		users = append(users, &github.User{Login: github.String(l)})
+	}
+	return users
+}
+
+func hasAssignee(issue *github.Issue, login string) bool {
+	for _, a := range issue.Assignees {
+		if a.GetLogin() == login {
+			return true
+		}
+	}
+	return false
+}
+
+func stringSlice(p *[]string) []string {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
+
+func hasAllLabels(issue *github.Issue, names []string) bool {
+	for _, want := range names {
+		found := false
+		for _, l := range issue.Labels {
+			if l.GetName() == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func splitCSV(s string) []string {
+	var out []string
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i == len(s) || s[i] == ',' {
+			out = append(out, s[start:i])
+			start = i + 1
+		}
+	}
+	return out
+}
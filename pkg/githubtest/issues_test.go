@@ -0,0 +1,127 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubtest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/github"
+)
+
+func TestFakeGitHubIssueCRUD(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+
+	if _, resp, err := client.Issues.Get(ctx, owner, repo, 42); err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Get Issue: wanted not found, got %+v, %v", resp, err)
+	}
+
+	created, resp, err := client.Issues.Create(ctx, owner, repo, &github.IssueRequest{
+		Title: github.String("it's broken"),
+		Body:  github.String("help"),
+	})
+	if err != nil || resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Create Issue: wanted Created, got %+v, %v", resp, err)
+	}
+	if created.GetState() != "open" {
+		t.Errorf("Create Issue: wanted state open, got %q", created.GetState())
+	}
+
+	got, _, err := client.Issues.Get(ctx, owner, repo, created.GetNumber())
+	if err != nil {
+		t.Fatalf("Get Issue: %v", err)
+	}
+	if diff := cmp.Diff(created, got); diff != "" {
+		t.Errorf("Get Issue: -want +got: %s", diff)
+	}
+
+	edited, _, err := client.Issues.Edit(ctx, owner, repo, created.GetNumber(), &github.IssueRequest{
+		State: github.String("closed"),
+	})
+	if err != nil {
+		t.Fatalf("Edit Issue: %v", err)
+	}
+	if edited.GetState() != "closed" {
+		t.Errorf("Edit Issue: wanted state closed, got %q", edited.GetState())
+	}
+
+	timeline, _, err := client.Issues.ListIssueTimeline(ctx, owner, repo, created.GetNumber(), nil)
+	if err != nil {
+		t.Fatalf("ListIssueTimeline: %v", err)
+	}
+	if len(timeline) != 1 || timeline[0].GetEvent() != "closed" {
+		t.Errorf("ListIssueTimeline: wanted [closed], got %+v", timeline)
+	}
+}
+
+func TestFakeGitHubListIssuesByRepo(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+
+	gh.AddIssue(&github.Issue{
+		Number: github.Int(1),
+		State:  github.String("open"),
+		Labels: []github.Label{{Name: github.String("bug")}},
+	})
+	gh.AddIssue(&github.Issue{
+		Number: github.Int(2),
+		State:  github.String("closed"),
+		Labels: []github.Label{{Name: github.String("wontfix")}},
+	})
+
+	open, _, err := client.Issues.ListByRepo(ctx, owner, repo, &github.IssueListByRepoOptions{State: "open"})
+	if err != nil {
+		t.Fatalf("ListByRepo(open): %v", err)
+	}
+	if len(open) != 1 || open[0].GetNumber() != 1 {
+		t.Errorf("ListByRepo(open): wanted [#1], got %+v", open)
+	}
+
+	buggy, _, err := client.Issues.ListByRepo(ctx, owner, repo, &github.IssueListByRepoOptions{State: "all", Labels: []string{"bug"}})
+	if err != nil {
+		t.Fatalf("ListByRepo(labels=bug): %v", err)
+	}
+	if len(buggy) != 1 || buggy[0].GetNumber() != 1 {
+		t.Errorf("ListByRepo(labels=bug): wanted [#1], got %+v", buggy)
+	}
+}
+
+func TestFakeGitHubListIssuesByRepoSince(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+
+	old := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	recent := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	gh.AddIssue(&github.Issue{Number: github.Int(1), State: github.String("open"), UpdatedAt: &old})
+	gh.AddIssue(&github.Issue{Number: github.Int(2), State: github.String("open"), UpdatedAt: &recent})
+
+	since := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	got, _, err := client.Issues.ListByRepo(ctx, owner, repo, &github.IssueListByRepoOptions{State: "all", Since: since})
+	if err != nil {
+		t.Fatalf("ListByRepo(since): %v", err)
+	}
+	if len(got) != 1 || got[0].GetNumber() != 2 {
+		t.Errorf("ListByRepo(since): wanted [#2], got %+v", got)
+	}
+}
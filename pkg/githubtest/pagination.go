@@ -0,0 +1,79 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// defaultPerPage mirrors the GitHub API's default page size.
+const defaultPerPage = 30
+
+// paginate reads the page/per_page query params off r, writes an RFC 5988
+// Link header (rel="next", rel="last") to w when there are more results,
+// and returns the [start, end) bounds of the requested page into a
+// total-length slice so the caller can do items[start:end].
+func paginate(w http.ResponseWriter, r *http.Request, total int) (start, end int) {
+	page := 1
+	if p, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && p > 0 {
+		page = p
+	}
+	perPage := defaultPerPage
+	if pp, err := strconv.Atoi(r.URL.Query().Get("per_page")); err == nil && pp > 0 {
+		perPage = pp
+	}
+
+	lastPage := (total + perPage - 1) / perPage
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	var links []string
+	if page < lastPage {
+		links = append(links, pageLink(r, page+1, perPage, "next"))
+	}
+	if lastPage > 1 && page != lastPage {
+		links = append(links, pageLink(r, lastPage, perPage, "last"))
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+
+	start = (page - 1) * perPage
+	if start > total {
+		start = total
+	}
+	end = start + perPage
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+// pageLink builds a GitHub-style Link header entry pointing back at r's
+// path with page/per_page overridden.
+func pageLink(r *http.Request, page, perPage int, rel string) string {
+	q := r.URL.Query()
+	q.Set("page", strconv.Itoa(page))
+	q.Set("per_page", strconv.Itoa(perPage))
+	u := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path, RawQuery: q.Encode()}
+	return fmt.Sprintf(`<%s>; rel=%q`, u.String(), rel)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+func TestFakeGitHubCommentsPagination(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+
+	const want = 5
+	for i := 0; i < want; i++ {
+		body := fmt.Sprintf("comment %d", i)
+		if _, _, err := client.Issues.CreateComment(ctx, owner, repo, prNum, &github.IssueComment{Body: &body}); err != nil {
+			t.Fatalf("CreateComment: %v", err)
+		}
+	}
+
+	var got []*github.IssueComment
+	opt := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 2}}
+	for {
+		comments, resp, err := client.Issues.ListComments(ctx, owner, repo, prNum, opt)
+		if err != nil {
+			t.Fatalf("ListComments: %v", err)
+		}
+		got = append(got, comments...)
+		if resp.NextPage == 0 {
+			if resp.LastPage != 0 && resp.LastPage != opt.Page {
+				t.Errorf("ListComments: wanted LastPage %d, got %d", opt.Page, resp.LastPage)
+			}
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	if len(got) != want {
+		t.Fatalf("ListComments: wanted %d comments across pages, got %d", want, len(got))
+	}
+	for i, c := range got {
+		if c.GetBody() != fmt.Sprintf("comment %d", i) {
+			t.Errorf("ListComments[%d]: wanted %q, got %q", i, fmt.Sprintf("comment %d", i), c.GetBody())
+		}
+	}
+}
+
+func TestFakeGitHubLabelsPagination(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+	gh.AddPullRequest(pr)
+
+	if _, _, err := client.Issues.AddLabelsToIssue(ctx, owner, repo, prNum, []string{"a", "b", "c"}); err != nil {
+		t.Fatalf("AddLabelsToIssue: %v", err)
+	}
+
+	first, resp, err := client.Issues.ListLabelsByIssue(ctx, owner, repo, prNum, &github.ListOptions{PerPage: 2})
+	if err != nil {
+		t.Fatalf("ListLabelsByIssue: %v", err)
+	}
+	if len(first) != 2 {
+		t.Fatalf("ListLabelsByIssue: wanted 2 labels on first page, got %d", len(first))
+	}
+	if resp.NextPage != 2 {
+		t.Fatalf("ListLabelsByIssue: wanted NextPage 2, got %d", resp.NextPage)
+	}
+
+	second, resp, err := client.Issues.ListLabelsByIssue(ctx, owner, repo, prNum, &github.ListOptions{Page: resp.NextPage, PerPage: 2})
+	if err != nil {
+		t.Fatalf("ListLabelsByIssue page 2: %v", err)
+	}
+	if len(second) != 1 {
+		t.Fatalf("ListLabelsByIssue page 2: wanted 1 label, got %d", len(second))
+	}
+	if resp.NextPage != 0 {
+		t.Errorf("ListLabelsByIssue page 2: wanted no NextPage, got %d", resp.NextPage)
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubtest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/go-github/github"
+)
+
+func (gh *FakeGitHub) listReviews(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	all := gh.Reviews[num]
+	start, end := paginate(w, r, len(all))
+	writeJSON(w, http.StatusOK, all[start:end])
+}
+
+func (gh *FakeGitHub) createReview(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	var req github.PullRequestReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	state := req.GetEvent()
+	if state == "" {
+		state = "PENDING"
+	}
+	review := &github.PullRequestReview{
+		ID:       github.Int64(gh.nextReviewID),
+		Body:     req.Body,
+		CommitID: req.CommitID,
+		State:    github.String(state),
+	}
+	gh.nextReviewID++
+	gh.Reviews[num] = append(gh.Reviews[num], review)
+	writeJSON(w, http.StatusOK, review)
+}
+
+func (gh *FakeGitHub) dismissReview(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	reviewID, ok := atoi64Or400(w, m[1])
+	if !ok {
+		return
+	}
+	var review *github.PullRequestReview
+	for _, rv := range gh.Reviews[num] {
+		if rv.GetID() == reviewID {
+			review = rv
+			break
+		}
+	}
+	if review == nil {
+		http.Error(w, "review not found", http.StatusNotFound)
+		return
+	}
+	review.State = github.String("DISMISSED")
+	writeJSON(w, http.StatusOK, review)
+}
+
+func (gh *FakeGitHub) listReviewers(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	reviewers := gh.RequestedReviewers[num]
+	if reviewers == nil {
+		reviewers = &github.Reviewers{}
+	}
+	writeJSON(w, http.StatusOK, reviewers)
+}
+
+func (gh *FakeGitHub) requestReviewers(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	pr, ok := gh.PullRequests[num]
+	if !ok {
+		http.Error(w, "pull request not found", http.StatusNotFound)
+		return
+	}
+	var req github.ReviewersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	users := make([]*github.User, 0, len(req.Reviewers))
+	for _, login := range req.Reviewers {
+		users = append(users, &github.User{Login: github.String(login)})
+	}
+	teams := make([]*github.Team, 0, len(req.TeamReviewers))
+	for _, slug := range req.TeamReviewers {
+		teams = append(teams, &github.Team{Slug: github.String(slug)})
+	}
+	gh.RequestedReviewers[num] = &github.Reviewers{Users: users, Teams: teams}
+
+	writeJSON(w, http.StatusCreated, pr)
+}
+
+func (gh *FakeGitHub) listFiles(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	all := gh.Files[num]
+	start, end := paginate(w, r, len(all))
+	writeJSON(w, http.StatusOK, all[start:end])
+}
+
+// mergeRequest mirrors go-github's unexported pullRequestMergeRequest,
+// which is what PullRequests.Merge actually sends on the wire.
+type mergeRequest struct {
+	CommitMessage string `json:"commit_message"`
+	MergeMethod   string `json:"merge_method"`
+	CommitTitle   string `json:"commit_title"`
+	SHA           string `json:"sha"`
+}
+
+func (gh *FakeGitHub) mergePullRequest(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	pr, ok := gh.PullRequests[num]
+	if !ok {
+		http.Error(w, "pull request not found", http.StatusNotFound)
+		return
+	}
+	var req mergeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if pr.GetMergeableState() == "dirty" {
+		writeJSON(w, http.StatusConflict, &github.PullRequestMergeResult{
+			Merged:  github.Bool(false),
+			Message: github.String("Pull Request is not mergeable, head branch was modified"),
+		})
+		return
+	}
+
+	sha := req.SHA
+	if sha == "" && pr.Head != nil {
+		sha = pr.Head.GetSHA()
+	}
+	method := req.MergeMethod
+	if method == "" {
+		method = "merge"
+	}
+	gh.MergeMethods[num] = method
+	pr.Merged = github.Bool(true)
+	pr.State = github.String("closed")
+
+	writeJSON(w, http.StatusOK, &github.PullRequestMergeResult{
+		SHA:     github.String(sha),
+		Merged:  github.Bool(true),
+		Message: github.String("Pull Request successfully merged"),
+	})
+}
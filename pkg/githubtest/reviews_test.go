@@ -0,0 +1,157 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubtest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+func TestFakeGitHubReviews(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+	gh.AddPullRequest(pr)
+
+	review, _, err := client.PullRequests.CreateReview(ctx, owner, repo, prNum, &github.PullRequestReviewRequest{
+		Event: github.String("APPROVE"),
+		Body:  github.String("LGTM"),
+	})
+	if err != nil {
+		t.Fatalf("CreateReview: %v", err)
+	}
+	if review.GetState() != "APPROVE" {
+		t.Errorf("CreateReview: wanted state APPROVE, got %q", review.GetState())
+	}
+
+	reviews, _, err := client.PullRequests.ListReviews(ctx, owner, repo, prNum, nil)
+	if err != nil || len(reviews) != 1 {
+		t.Fatalf("ListReviews: wanted 1 review, got %+v, %v", reviews, err)
+	}
+
+	dismissed, _, err := client.PullRequests.DismissReview(ctx, owner, repo, prNum, review.GetID(), &github.PullRequestReviewDismissalRequest{
+		Message: github.String("stale"),
+	})
+	if err != nil {
+		t.Fatalf("DismissReview: %v", err)
+	}
+	if dismissed.GetState() != "DISMISSED" {
+		t.Errorf("DismissReview: wanted state DISMISSED, got %q", dismissed.GetState())
+	}
+}
+
+func TestFakeGitHubReviewers(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+	gh.AddPullRequest(pr)
+
+	if _, _, err := client.PullRequests.RequestReviewers(ctx, owner, repo, prNum, github.ReviewersRequest{
+		Reviewers: []string{"alice", "bob"},
+	}); err != nil {
+		t.Fatalf("RequestReviewers: %v", err)
+	}
+
+	reviewers, _, err := client.PullRequests.ListReviewers(ctx, owner, repo, prNum, nil)
+	if err != nil {
+		t.Fatalf("ListReviewers: %v", err)
+	}
+	if len(reviewers.Users) != 2 {
+		t.Fatalf("ListReviewers: wanted 2 users, got %+v", reviewers.Users)
+	}
+}
+
+func TestFakeGitHubRequestReviewersNoSuchPR(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+
+	if _, resp, err := client.PullRequests.RequestReviewers(ctx, owner, repo, 404, github.ReviewersRequest{
+		Reviewers: []string{"alice"},
+	}); err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("RequestReviewers: wanted not found, got %+v, %v", resp, err)
+	}
+	if _, ok := gh.RequestedReviewers[404]; ok {
+		t.Errorf("RequestReviewers: wanted no state recorded for nonexistent PR, got %+v", gh.RequestedReviewers[404])
+	}
+}
+
+func TestFakeGitHubMerge(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+
+	dirty := &github.PullRequest{
+		Number:         github.Int(2),
+		MergeableState: github.String("dirty"),
+	}
+	gh.AddPullRequest(dirty)
+
+	if _, resp, err := client.PullRequests.Merge(ctx, owner, repo, dirty.GetNumber(), "", nil); err == nil || resp.StatusCode != http.StatusConflict {
+		t.Fatalf("Merge dirty PR: wanted 409, got %+v, %v", resp, err)
+	}
+
+	clean := &github.PullRequest{
+		Number:         github.Int(prNum),
+		MergeableState: github.String("clean"),
+		Head:           &github.PullRequestBranch{SHA: github.String("cafe")},
+	}
+	gh.AddPullRequest(clean)
+
+	result, _, err := client.PullRequests.Merge(ctx, owner, repo, clean.GetNumber(), "", &github.PullRequestOptions{MergeMethod: "squash"})
+	if err != nil {
+		t.Fatalf("Merge: %v", err)
+	}
+	if !result.GetMerged() {
+		t.Errorf("Merge: wanted merged, got %+v", result)
+	}
+	if result.GetSHA() != "cafe" {
+		t.Errorf("Merge: wanted SHA cafe, got %q", result.GetSHA())
+	}
+	if gh.MergeMethods[clean.GetNumber()] != "squash" {
+		t.Errorf("MergeMethods: wanted squash, got %q", gh.MergeMethods[clean.GetNumber()])
+	}
+
+	got, _, err := client.PullRequests.Get(ctx, owner, repo, clean.GetNumber())
+	if err != nil {
+		t.Fatalf("Get PullRequest after merge: %v", err)
+	}
+	if !got.GetMerged() || got.GetState() != "closed" {
+		t.Errorf("Get PullRequest after merge: wanted merged+closed, got %+v", got)
+	}
+}
+
+func TestFakeGitHubListFiles(t *testing.T) {
+	ctx := context.Background()
+	gh := NewFakeGitHub()
+	client, _ := Setup(ctx, t, &Config{GitHub: gh})
+	gh.AddPullRequest(pr)
+	gh.AddPullRequestFiles(prNum, []*github.CommitFile{
+		{Filename: github.String("main.go"), Additions: github.Int(10)},
+	})
+
+	files, _, err := client.PullRequests.ListFiles(ctx, owner, repo, prNum, nil)
+	if err != nil {
+		t.Fatalf("ListFiles: %v", err)
+	}
+	if len(files) != 1 || files[0].GetFilename() != "main.go" {
+		t.Errorf("ListFiles: wanted [main.go], got %+v", files)
+	}
+}
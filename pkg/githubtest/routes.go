@@ -0,0 +1,189 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/google/go-github/github"
+)
+
+// route associates a method and path pattern with the FakeGitHub method
+// that serves it. Capture groups in path are passed to handler in order.
+var routes = []struct {
+	method  string
+	path    *regexp.Regexp
+	handler func(*FakeGitHub, http.ResponseWriter, *http.Request, []string)
+}{
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/pulls/(\d+)$`), (*FakeGitHub).getPullRequest},
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/issues/(\d+)/comments$`), (*FakeGitHub).listComments},
+	{http.MethodPost, regexp.MustCompile(`^/repos/[^/]+/[^/]+/issues/(\d+)/comments$`), (*FakeGitHub).createComment},
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/commits/([^/]+)/status$`), (*FakeGitHub).getCombinedStatus},
+	{http.MethodPost, regexp.MustCompile(`^/repos/[^/]+/[^/]+/statuses/([^/]+)$`), (*FakeGitHub).createStatus},
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/issues/(\d+)/labels$`), (*FakeGitHub).listLabels},
+	{http.MethodPost, regexp.MustCompile(`^/repos/[^/]+/[^/]+/issues/(\d+)/labels$`), (*FakeGitHub).addLabels},
+	{http.MethodPut, regexp.MustCompile(`^/repos/[^/]+/[^/]+/issues/(\d+)/labels$`), (*FakeGitHub).replaceLabels},
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/issues/(\d+)/timeline$`), (*FakeGitHub).listIssueTimeline},
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/issues$`), (*FakeGitHub).listIssuesByRepo},
+	{http.MethodPost, regexp.MustCompile(`^/repos/[^/]+/[^/]+/issues$`), (*FakeGitHub).createIssue},
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/issues/(\d+)$`), (*FakeGitHub).getIssue},
+	{http.MethodPatch, regexp.MustCompile(`^/repos/[^/]+/[^/]+/issues/(\d+)$`), (*FakeGitHub).editIssue},
+	{http.MethodPost, regexp.MustCompile(`^/repos/[^/]+/[^/]+/check-runs$`), (*FakeGitHub).createCheckRun},
+	{http.MethodPatch, regexp.MustCompile(`^/repos/[^/]+/[^/]+/check-runs/(\d+)$`), (*FakeGitHub).updateCheckRun},
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/check-runs/(\d+)/annotations$`), (*FakeGitHub).listCheckRunAnnotations},
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/commits/([^/]+)/check-runs$`), (*FakeGitHub).listCheckRunsForRef},
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/pulls/(\d+)/reviews$`), (*FakeGitHub).listReviews},
+	{http.MethodPost, regexp.MustCompile(`^/repos/[^/]+/[^/]+/pulls/(\d+)/reviews$`), (*FakeGitHub).createReview},
+	{http.MethodPut, regexp.MustCompile(`^/repos/[^/]+/[^/]+/pulls/(\d+)/reviews/(\d+)/dismissals$`), (*FakeGitHub).dismissReview},
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/pulls/(\d+)/requested_reviewers$`), (*FakeGitHub).listReviewers},
+	{http.MethodPost, regexp.MustCompile(`^/repos/[^/]+/[^/]+/pulls/(\d+)/requested_reviewers$`), (*FakeGitHub).requestReviewers},
+	{http.MethodPut, regexp.MustCompile(`^/repos/[^/]+/[^/]+/pulls/(\d+)/merge$`), (*FakeGitHub).mergePullRequest},
+	{http.MethodGet, regexp.MustCompile(`^/repos/[^/]+/[^/]+/pulls/(\d+)/files$`), (*FakeGitHub).listFiles},
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v == nil {
+		return
+	}
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func atoiOr400(w http.ResponseWriter, s string) (int, bool) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return 0, false
+	}
+	return n, true
+}
+
+func (gh *FakeGitHub) getPullRequest(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	pr, ok := gh.PullRequests[num]
+	if !ok {
+		http.Error(w, "pull request not found", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, pr)
+}
+
+func (gh *FakeGitHub) listComments(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	all := gh.Comments[num]
+	start, end := paginate(w, r, len(all))
+	writeJSON(w, http.StatusOK, all[start:end])
+}
+
+func (gh *FakeGitHub) createComment(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	var c github.IssueComment
+	if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	gh.Comments[num] = append(gh.Comments[num], &c)
+	gh.addTimelineEvent(num, "commented")
+	writeJSON(w, http.StatusCreated, &c)
+}
+
+func (gh *FakeGitHub) getCombinedStatus(w http.ResponseWriter, r *http.Request, m []string) {
+	sha := m[0]
+	statuses := gh.Statuses[sha]
+	start, end := paginate(w, r, len(statuses))
+	page := statuses[start:end]
+	values := make([]github.RepoStatus, 0, len(page))
+	for _, s := range page {
+		values = append(values, *s)
+	}
+	writeJSON(w, http.StatusOK, &github.CombinedStatus{
+		TotalCount: github.Int(len(statuses)),
+		Statuses:   values,
+	})
+}
+
+func (gh *FakeGitHub) createStatus(w http.ResponseWriter, r *http.Request, m []string) {
+	sha := m[0]
+	var s github.RepoStatus
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	gh.Statuses[sha] = append(gh.Statuses[sha], &s)
+	writeJSON(w, http.StatusCreated, &s)
+}
+
+func (gh *FakeGitHub) listLabels(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	all := gh.Labels[num]
+	start, end := paginate(w, r, len(all))
+	writeJSON(w, http.StatusOK, all[start:end])
+}
+
+func (gh *FakeGitHub) addLabels(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	var names []string
+	if err := json.NewDecoder(r.Body).Decode(&names); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, n := range names {
+		gh.Labels[num] = append(gh.Labels[num], &github.Label{Name: github.String(n)})
+		gh.addTimelineEvent(num, "labeled")
+	}
+	writeJSON(w, http.StatusOK, gh.Labels[num])
+}
+
+func (gh *FakeGitHub) replaceLabels(w http.ResponseWriter, r *http.Request, m []string) {
+	num, ok := atoiOr400(w, m[0])
+	if !ok {
+		return
+	}
+	var names []string
+	if err := json.NewDecoder(r.Body).Decode(&names); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	labels := make([]*github.Label, 0, len(names))
+	for _, n := range names {
+		labels = append(labels, &github.Label{Name: github.String(n)})
+		gh.addTimelineEvent(num, "labeled")
+	}
+	gh.Labels[num] = labels
+	writeJSON(w, http.StatusOK, labels)
+}